@@ -0,0 +1,26 @@
+package invalidblocks
+
+// Config configures the on-disk invalid block store.
+type Config struct {
+	// Dir is the directory entries are written to. Required.
+	Dir string
+	// MaxBackups is the maximum number of entries retained before the
+	// oldest are evicted, FIFO, mirroring the log package's MaxBackups
+	// flag. Zero means unlimited.
+	MaxBackups int
+	// MaxTotalSizeBytes caps the combined size of retained ssz payloads;
+	// like MaxBackups, the oldest entries are evicted first once the cap
+	// is exceeded. Zero means unlimited.
+	MaxTotalSizeBytes int64
+	// Compress gzip-encodes each ssz payload on disk when true.
+	Compress bool
+}
+
+// DefaultConfig mirrors the defaults used for the equivalent log rotation
+// settings: keep the last 50 entries, uncompressed, with no size cap.
+func DefaultConfig(dir string) Config {
+	return Config{
+		Dir:        dir,
+		MaxBackups: 50,
+	}
+}
@@ -0,0 +1,10 @@
+// Package invalidblocks persists gossip blocks that failed verification to
+// disk for post-mortem debugging, replacing the old ad-hoc temp-file dump.
+//
+// Entries live under a configurable directory, subject to a FIFO eviction
+// policy (max count and/or max total size, mirroring the log package's
+// MaxBackups knob) so the directory can't grow without bound across a long
+// chain of forks or replays. A JSON sidecar index next to the ssz payloads
+// records why each block was rejected and, when known, which peer gossiped
+// it, so entries can be listed or exported without re-parsing every file.
+package invalidblocks
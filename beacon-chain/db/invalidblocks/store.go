@@ -0,0 +1,246 @@
+package invalidblocks
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "invalidblocks")
+
+const indexFilename = "index.json"
+
+// Entry is one record in the index, describing a single saved invalid block.
+type Entry struct {
+	Slot             primitives.Slot           `json:"slot"`
+	ProposerIndex    primitives.ValidatorIndex `json:"proposer_index"`
+	Root             [32]byte                  `json:"root"`
+	Reason           string                    `json:"reason"`
+	InvalidAncestors [][32]byte                `json:"invalid_ancestors,omitempty"`
+	ReceivedFrom     string                    `json:"received_from,omitempty"`
+	SavedAt          time.Time                 `json:"saved_at"`
+	Filename         string                    `json:"filename"`
+	SizeBytes        int64                     `json:"size_bytes"`
+}
+
+// Store persists invalid blocks and a JSON sidecar index describing why
+// each one was rejected. All exported methods are safe for concurrent use.
+type Store struct {
+	mu    sync.Mutex
+	cfg   Config
+	index []Entry // oldest first, mirrors the on-disk index file
+}
+
+// New opens (or initializes) a Store rooted at cfg.Dir, loading any existing
+// index left behind by a previous run.
+func New(cfg Config) (*Store, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("invalidblocks: Dir must be set")
+	}
+	if err := file.MkdirAll(cfg.Dir); err != nil {
+		return nil, errors.Wrap(err, "could not create invalid block directory")
+	}
+	s := &Store{cfg: cfg}
+	if err := s.loadIndex(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Save ssz-encodes signed, optionally gzip-compresses it, and writes it to
+// disk alongside an index entry recording reason, invalidAncestors, and the
+// peer that gossiped it (from may be "" when unknown). It then evicts the
+// oldest entries until the store is back within its configured limits.
+func (s *Store) Save(_ context.Context, signed interfaces.ReadOnlySignedBeaconBlock, reason string, cause error, invalidAncestors [][32]byte, from peer.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	block := signed.Block()
+	root, err := block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash invalid block")
+	}
+
+	enc, err := signed.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not ssz encode invalid block")
+	}
+
+	ext := "ssz"
+	if s.cfg.Compress {
+		enc, err = gzipEncode(enc)
+		if err != nil {
+			return errors.Wrap(err, "could not gzip invalid block")
+		}
+		ext = "ssz.gz"
+	}
+
+	filename := fmt.Sprintf("%d_%d_%x.%s", block.Slot(), block.ProposerIndex(), root, ext)
+	if err := file.WriteFile(filepath.Join(s.cfg.Dir, filename), enc); err != nil {
+		return errors.Wrap(err, "could not write invalid block to disk")
+	}
+
+	if cause != nil {
+		reason = fmt.Sprintf("%s: %s", reason, cause.Error())
+	}
+	entry := Entry{
+		Slot:             block.Slot(),
+		ProposerIndex:    block.ProposerIndex(),
+		Root:             root,
+		Reason:           reason,
+		InvalidAncestors: invalidAncestors,
+		ReceivedFrom:     from.String(),
+		SavedAt:          time.Now(),
+		Filename:         filename,
+		SizeBytes:        int64(len(enc)),
+	}
+	s.index = append(s.index, entry)
+
+	if err := s.evict(); err != nil {
+		return err
+	}
+	return s.saveIndex()
+}
+
+// List returns a copy of the current index, oldest entry first.
+func (s *Store) List() []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Entry, len(s.index))
+	copy(out, s.index)
+	return out
+}
+
+// Get returns the index entry and raw (decompressed) ssz payload for root,
+// or an error if no entry matches.
+func (s *Store) Get(root [32]byte) (*Entry, []byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.index {
+		if e.Root != root {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(s.cfg.Dir, e.Filename))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not read invalid block from disk")
+		}
+		// Decide by the entry's own filename, not the current config, since
+		// the index may hold entries written by a run with Compress toggled.
+		if strings.HasSuffix(e.Filename, ".gz") {
+			raw, err = gzipDecode(raw)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not gunzip invalid block")
+			}
+		}
+		entry := e
+		return &entry, raw, nil
+	}
+	return nil, nil, errors.Errorf("no invalid block entry for root %#x", root)
+}
+
+// evict removes the oldest entries, both from the in-memory index and from
+// disk, until MaxBackups and MaxTotalSizeBytes are both satisfied. Callers
+// must hold s.mu.
+func (s *Store) evict() error {
+	for s.overCapacity() {
+		oldest := s.index[0]
+		if err := os.Remove(filepath.Join(s.cfg.Dir, oldest.Filename)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "could not evict invalid block entry")
+		}
+		s.index = s.index[1:]
+	}
+	return nil
+}
+
+func (s *Store) overCapacity() bool {
+	if len(s.index) == 0 {
+		return false
+	}
+	if s.cfg.MaxBackups > 0 && len(s.index) > s.cfg.MaxBackups {
+		return true
+	}
+	if s.cfg.MaxTotalSizeBytes > 0 && s.totalSize() > s.cfg.MaxTotalSizeBytes {
+		return true
+	}
+	return false
+}
+
+func (s *Store) totalSize() int64 {
+	var total int64
+	for _, e := range s.index {
+		total += e.SizeBytes
+	}
+	return total
+}
+
+func (s *Store) loadIndex() error {
+	fp := filepath.Join(s.cfg.Dir, indexFilename)
+	raw, err := os.ReadFile(fp)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read invalid block index")
+	}
+	var entries []Entry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return errors.Wrap(err, "could not unmarshal invalid block index")
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].SavedAt.Before(entries[j].SavedAt) })
+	s.index = entries
+	return nil
+}
+
+// saveIndex must be called with s.mu held.
+func (s *Store) saveIndex() error {
+	raw, err := json.MarshalIndent(s.index, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal invalid block index")
+	}
+	if err := file.WriteFile(filepath.Join(s.cfg.Dir, indexFilename), raw); err != nil {
+		return errors.Wrap(err, "could not write invalid block index")
+	}
+	return nil
+}
+
+func gzipEncode(raw []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gzipDecode(raw []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := r.Close(); cerr != nil {
+			log.WithError(cerr).Debug("Failed to close gzip reader")
+		}
+	}()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,100 @@
+package invalidblocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestNew_RequiresDir(t *testing.T) {
+	_, err := New(Config{})
+	require.ErrorContains(t, "Dir must be set", err)
+}
+
+func TestStore_SaveAndList(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(Config{Dir: dir, MaxBackups: 50})
+	require.NoError(t, err)
+
+	b := util.NewBeaconBlockBellatrix()
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Save(context.Background(), signed, "failed gossip validation", nil, nil, ""))
+
+	entries := s.List()
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, "failed gossip validation", entries[0].Reason)
+}
+
+func TestStore_SaveAppendsCauseToReason(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(Config{Dir: dir, MaxBackups: 50})
+	require.NoError(t, err)
+
+	b := util.NewBeaconBlockBellatrix()
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Save(context.Background(), signed, "failed gossip validation", errors.New("boom"), nil, ""))
+
+	entries := s.List()
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, "failed gossip validation: boom", entries[0].Reason)
+}
+
+func TestStore_Get(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(Config{Dir: dir, MaxBackups: 50, Compress: true})
+	require.NoError(t, err)
+
+	b := util.NewBeaconBlockBellatrix()
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, s.Save(context.Background(), signed, "reason", nil, nil, ""))
+
+	entries := s.List()
+	require.Equal(t, 1, len(entries))
+
+	entry, raw, err := s.Get(entries[0].Root)
+	require.NoError(t, err)
+	require.Equal(t, entries[0].Filename, entry.Filename)
+
+	wantRaw, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+	require.DeepEqual(t, wantRaw, raw)
+}
+
+func TestStore_Get_UnknownRoot(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(Config{Dir: dir, MaxBackups: 50})
+	require.NoError(t, err)
+
+	_, _, err = s.Get([32]byte{1})
+	require.ErrorContains(t, "no invalid block entry", err)
+}
+
+func TestStore_EvictsOldestOverMaxBackups(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(Config{Dir: dir, MaxBackups: 1})
+	require.NoError(t, err)
+
+	b1 := util.NewBeaconBlockBellatrix()
+	signed1, err := blocks.NewSignedBeaconBlock(b1)
+	require.NoError(t, err)
+	require.NoError(t, s.Save(context.Background(), signed1, "first", nil, nil, ""))
+
+	b2 := util.NewBeaconBlockBellatrix()
+	b2.Block.Slot = 1
+	signed2, err := blocks.NewSignedBeaconBlock(b2)
+	require.NoError(t, err)
+	require.NoError(t, s.Save(context.Background(), signed2, "second", nil, nil, ""))
+
+	entries := s.List()
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, "second", entries[0].Reason)
+}
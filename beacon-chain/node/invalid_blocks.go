@@ -0,0 +1,35 @@
+// Package node assembles the beacon-chain services that need to share state
+// but can't see each other's unexported config, e.g. sync and the debug RPC
+// server both reading and writing the same invalid block store.
+package node
+
+import (
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/debug"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/urfave/cli/v2"
+)
+
+// RegisterInvalidBlockStore opens a single *invalidblocks.Store from the
+// invalid-block-store-* CLI flags (nil if InvalidBlockStorePathFlag is
+// unset), registers the debug export routes for it on mux, and returns the
+// sync.Option that threads that same store into the sync service - so the
+// gossip path and the /prysm/v1/debug/invalid-blocks API never disagree
+// about what's on disk.
+func RegisterInvalidBlockStore(cliCtx *cli.Context, mux *http.ServeMux) (sync.Option, error) {
+	cfg := sync.InvalidBlockConfigFromContext(cliCtx)
+
+	var store *invalidblocks.Store
+	if cfg.Dir != "" {
+		var err error
+		store, err = invalidblocks.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	debug.NewServer(mux, store)
+	return sync.WithInvalidBlockStore(store), nil
+}
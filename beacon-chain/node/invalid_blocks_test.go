@@ -0,0 +1,63 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/urfave/cli/v2"
+)
+
+func cliContext(t *testing.T, dir string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	require.NoError(t, flags.InvalidBlockStorePathFlag.Apply(set))
+	require.NoError(t, flags.InvalidBlockStoreMaxBackupsFlag.Apply(set))
+	require.NoError(t, flags.InvalidBlockStoreCompressFlag.Apply(set))
+	if dir != "" {
+		require.NoError(t, set.Set(flags.InvalidBlockStorePathFlag.Name, dir))
+	}
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
+
+func TestRegisterInvalidBlockStore_DisabledWhenPathUnset(t *testing.T) {
+	mux := http.NewServeMux()
+	opt, err := RegisterInvalidBlockStore(cliContext(t, ""), mux)
+	require.NoError(t, err)
+	require.Equal(t, true, opt != nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/prysm/v1/debug/invalid-blocks", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRegisterInvalidBlockStore_SharesInstanceWithSyncOption(t *testing.T) {
+	mux := http.NewServeMux()
+	opt, err := RegisterInvalidBlockStore(cliContext(t, t.TempDir()), mux)
+	require.NoError(t, err)
+
+	s, err := sync.NewService(opt)
+	require.NoError(t, err)
+	require.Equal(t, true, s.InvalidBlockStore() != nil)
+
+	// A block saved through the sync side must show up on the debug route.
+	signed, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+	require.NoError(t, err)
+	require.NoError(t, s.InvalidBlockStore().Save(context.Background(), signed, "reason", nil, nil, ""))
+
+	req := httptest.NewRequest(http.MethodGet, "/prysm/v1/debug/invalid-blocks", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var entries []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+	require.Equal(t, 1, len(entries))
+}
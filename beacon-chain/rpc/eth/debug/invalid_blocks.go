@@ -0,0 +1,104 @@
+package debug
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/httputil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"go.opencensus.io/trace"
+)
+
+// invalidBlockEntryJSON is the wire representation of an invalidblocks.Entry,
+// hex-encoding the fields that are raw bytes in-process.
+type invalidBlockEntryJSON struct {
+	Slot             string   `json:"slot"`
+	ProposerIndex    string   `json:"proposer_index"`
+	Root             string   `json:"root"`
+	Reason           string   `json:"reason"`
+	InvalidAncestors []string `json:"invalid_ancestors,omitempty"`
+	ReceivedFrom     string   `json:"received_from,omitempty"`
+	SavedAt          string   `json:"saved_at"`
+	Filename         string   `json:"filename"`
+}
+
+func entryToJSON(e invalidblocks.Entry) *invalidBlockEntryJSON {
+	ancestors := make([]string, len(e.InvalidAncestors))
+	for i, a := range e.InvalidAncestors {
+		ancestors[i] = hexRoot(a)
+	}
+	return &invalidBlockEntryJSON{
+		Slot:             fmtUint(uint64(e.Slot)),
+		ProposerIndex:    fmtUint(uint64(e.ProposerIndex)),
+		Root:             hexRoot(e.Root),
+		Reason:           e.Reason,
+		InvalidAncestors: ancestors,
+		ReceivedFrom:     e.ReceivedFrom,
+		SavedAt:          e.SavedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		Filename:         e.Filename,
+	}
+}
+
+func hexRoot(r [32]byte) string {
+	return "0x" + hex.EncodeToString(r[:])
+}
+
+func fmtUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+// ListInvalidBlocks handles GET /prysm/v1/debug/invalid-blocks, returning
+// every entry currently retained by the node's invalid block store, oldest
+// first.
+func (s *Server) ListInvalidBlocks(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.ListInvalidBlocks")
+	defer span.End()
+
+	if s.InvalidBlockStore == nil {
+		httputil.WriteJson(w, []*invalidBlockEntryJSON{})
+		return
+	}
+
+	entries := s.InvalidBlockStore.List()
+	resp := make([]*invalidBlockEntryJSON, len(entries))
+	for i, e := range entries {
+		resp[i] = entryToJSON(e)
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// GetInvalidBlock handles GET /prysm/v1/debug/invalid-blocks/{root}, exporting
+// the raw ssz payload for a single invalid block, with the entry's reason in
+// the X-Invalid-Block-Reason header.
+func (s *Server) GetInvalidBlock(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.GetInvalidBlock")
+	defer span.End()
+
+	if s.InvalidBlockStore == nil {
+		httputil.HandleError(w, "invalid block store is disabled", http.StatusNotFound)
+		return
+	}
+
+	rootParam := strings.TrimPrefix(r.PathValue("root"), "0x")
+	rootBytes, err := hex.DecodeString(rootParam)
+	if err != nil || len(rootBytes) != 32 {
+		httputil.HandleError(w, "invalid root", http.StatusBadRequest)
+		return
+	}
+	var root [32]byte
+	copy(root[:], rootBytes)
+
+	entry, raw, err := s.InvalidBlockStore.Get(root)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("X-Invalid-Block-Reason", entry.Reason)
+	if _, err := w.Write(raw); err != nil {
+		log.WithError(err).Error("Failed to write invalid block export")
+	}
+}
@@ -0,0 +1,69 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testStore(t *testing.T) *invalidblocks.Store {
+	s, err := invalidblocks.New(invalidblocks.Config{Dir: t.TempDir(), MaxBackups: 50})
+	require.NoError(t, err)
+	return s
+}
+
+func TestListInvalidBlocks_EmptyStoreDisabled(t *testing.T) {
+	s := &Server{}
+	req := httptest.NewRequest(http.MethodGet, "/prysm/v1/debug/invalid-blocks", nil)
+	w := httptest.NewRecorder()
+
+	s.ListInvalidBlocks(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "[]", trimNewline(w.Body.String()))
+}
+
+func TestListInvalidBlocks_ReturnsSavedEntries(t *testing.T) {
+	store := testStore(t)
+
+	b := util.NewBeaconBlockBellatrix()
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, store.Save(context.Background(), signed, "failed gossip validation", nil, nil, ""))
+
+	s := &Server{InvalidBlockStore: store}
+	req := httptest.NewRequest(http.MethodGet, "/prysm/v1/debug/invalid-blocks", nil)
+	w := httptest.NewRecorder()
+	s.ListInvalidBlocks(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var entries []invalidBlockEntryJSON
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, "failed gossip validation", entries[0].Reason)
+}
+
+func TestGetInvalidBlock_DisabledStore(t *testing.T) {
+	s := &Server{}
+	mux := http.NewServeMux()
+	s.RegisterInvalidBlockRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/prysm/v1/debug/invalid-blocks/0x00", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func trimNewline(s string) string {
+	for len(s) > 0 && (s[len(s)-1] == '\n' || s[len(s)-1] == '\r') {
+		s = s[:len(s)-1]
+	}
+	return s
+}
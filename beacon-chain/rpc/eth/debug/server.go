@@ -0,0 +1,37 @@
+package debug
+
+import (
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "rpc/eth/debug")
+
+// Server defines the debug service implementation for serving endpoints that
+// require a direct read from the beacon node's debug-only state, such as the
+// invalid block export API.
+type Server struct {
+	InvalidBlockStore *invalidblocks.Store
+}
+
+// NewServer builds a Server backed by store and registers its routes on mux.
+// store should be the same *invalidblocks.Store instance passed to
+// sync.WithInvalidBlockStore, so the gossip path and this export API agree on
+// what's on disk; pass nil when no invalid block store path is configured, in
+// which case the routes still register and report the store as empty/not
+// found rather than panicking.
+func NewServer(mux *http.ServeMux, store *invalidblocks.Store) *Server {
+	s := &Server{InvalidBlockStore: store}
+	s.RegisterInvalidBlockRoutes(mux)
+	return s
+}
+
+// RegisterInvalidBlockRoutes attaches the invalid-block export endpoints to
+// mux. NewServer already calls this; use it directly only if a Server was
+// built some other way (e.g. the Server{} literals in this package's tests).
+func (s *Server) RegisterInvalidBlockRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /prysm/v1/debug/invalid-blocks", s.ListInvalidBlocks)
+	mux.HandleFunc("GET /prysm/v1/debug/invalid-blocks/{root}", s.GetInvalidBlock)
+}
@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+)
+
+// BuilderBlobFetcher knows how to ask a builder/relay HTTP endpoint for the
+// sidecars it produced for a given block. It is satisfied by the existing
+// builder API client; kept as a narrow interface here so builderSource can
+// be constructed without importing the full builder package graph.
+type BuilderBlobFetcher interface {
+	GetBlobSidecars(ctx context.Context, blockRoot [32]byte, indices map[uint64]bool) ([]blocks.VerifiedROBlob, error)
+}
+
+// builderSource is optional: it is only included in the chain when a
+// builder/relay endpoint is configured (see BlobSourceConfig.Builder). It
+// runs after peer req/resp since it depends on third-party infrastructure
+// outside the beacon chain's own peerset.
+type builderSource struct {
+	fetcher BuilderBlobFetcher
+}
+
+// newBuilderSource wraps a BuilderBlobFetcher as a BlobSource. Returns nil
+// if client is nil so callers can omit it from the chain unconditionally.
+func newBuilderSource(client BuilderBlobFetcher) *builderSource {
+	if client == nil {
+		return nil
+	}
+	return &builderSource{fetcher: client}
+}
+
+func (s *builderSource) Name() string {
+	return "builder_relay"
+}
+
+func (s *builderSource) Fetch(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, missing map[uint64]bool) ([]blocks.VerifiedROBlob, error) {
+	sidecars, err := s.fetcher.GetBlobSidecars(ctx, blockRoot, missing)
+	if err != nil {
+		if errIsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return sidecars, nil
+}
+
+// errIsNotFound treats a 404 from the relay as "doesn't have it" rather than
+// a hard failure, since most blocks were never built through any one relay.
+func errIsNotFound(err error) bool {
+	type statusCoder interface {
+		StatusCode() int
+	}
+	sc, ok := err.(statusCoder)
+	return ok && sc.StatusCode() == http.StatusNotFound
+}
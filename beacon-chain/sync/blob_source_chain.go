@@ -0,0 +1,140 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+)
+
+var (
+	blobSourceAttemptCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blob_source_attempt_total",
+		Help: "Number of blob sidecar reconstruction attempts per source, labeled by outcome.",
+	}, []string{"source", "outcome"})
+	blobSourceLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "blob_source_duration_seconds",
+		Help:    "Time spent waiting on a single blob source during reconstruction.",
+		Buckets: []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"source"})
+	// blobRecoveredCount replaces the old EL-only blobRecoveredFromELCount:
+	// source is the comma-joined list of sources that contributed to a given
+	// Reconstruct call (see Reconstruct's second return value), so dashboards
+	// no longer mislabel peer/builder/disk-cache recoveries as EL.
+	blobRecoveredCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blob_recovered_total",
+		Help: "Number of blob sidecars successfully recovered via reconstruction, labeled by the contributing source(s).",
+	}, []string{"source"})
+)
+
+// BlobSource is one origin a BlobSourceChain can draw missing blob sidecars
+// from, e.g. the local execution layer, a peer-of-peers req/resp round trip,
+// a builder/relay HTTP endpoint, or a local disk cache. Implementations
+// should only return the sidecars they were able to produce and must not
+// block past the context deadline set by the chain.
+type BlobSource interface {
+	// Name identifies the source for logging and metrics.
+	Name() string
+	// Fetch attempts to retrieve sidecars for the given missing indices.
+	// A partial result alongside a non-nil error is acceptable; the chain
+	// keeps whatever was returned and moves on to the next source for the
+	// indices that remain unfilled.
+	Fetch(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, missing map[uint64]bool) ([]blocks.VerifiedROBlob, error)
+}
+
+// BlobSourceChain tries a prioritized list of BlobSources in order, merging
+// whatever sidecars each source produces (deduped by (BlockRoot, Index))
+// until every missing index has been filled or the chain is exhausted.
+type BlobSourceChain struct {
+	sources []BlobSource
+	timeout time.Duration
+}
+
+// NewBlobSourceChain builds a chain from an already-ordered list of enabled
+// sources. Ordering reflects source priority and is resolved once, from
+// BlobSourceConfig, at construction time so that Reconstruct stays a pure merge.
+func NewBlobSourceChain(timeout time.Duration, sources ...BlobSource) *BlobSourceChain {
+	return &BlobSourceChain{sources: sources, timeout: timeout}
+}
+
+type blobSidecarKey struct {
+	root  [32]byte
+	index uint64
+}
+
+// Reconstruct walks the chain in priority order. present[i] == true means
+// index i is already in the blob store; Reconstruct only asks sources to
+// fill the indices that are still missing and short-circuits as soon as
+// none remain. The second return value lists, in the order they were tried,
+// the names of the sources that actually contributed a sidecar - callers use
+// it to attribute reconstruction latency to a source for dashboards.
+func (c *BlobSourceChain) Reconstruct(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, present [fieldparams.MaxBlobsPerBlock]bool) ([]blocks.VerifiedROBlob, []string, error) {
+	commitments, err := block.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missing := make(map[uint64]bool, len(commitments))
+	for i := range commitments {
+		if !present[i] {
+			missing[uint64(i)] = true
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil, nil
+	}
+
+	merged := make(map[blobSidecarKey]blocks.VerifiedROBlob, len(missing))
+	var sources []string
+	var lastErr error
+	for _, src := range c.sources {
+		if len(missing) == 0 {
+			break
+		}
+
+		srcCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		start := time.Now()
+		found, fetchErr := src.Fetch(srcCtx, block, blockRoot, missing)
+		cancel()
+		blobSourceLatency.WithLabelValues(src.Name()).Observe(time.Since(start).Seconds())
+
+		if fetchErr != nil {
+			blobSourceAttemptCount.WithLabelValues(src.Name(), "error").Inc()
+			lastErr = fetchErr
+			log.WithError(fetchErr).WithField("source", src.Name()).Debug("Blob source failed to reconstruct sidecars")
+		}
+		if len(found) == 0 {
+			blobSourceAttemptCount.WithLabelValues(src.Name(), "empty").Inc()
+			continue
+		}
+		blobSourceAttemptCount.WithLabelValues(src.Name(), "filled").Inc()
+
+		contributed := false
+		for _, sc := range found {
+			key := blobSidecarKey{root: blockRoot, index: sc.Index}
+			if _, ok := merged[key]; ok {
+				continue
+			}
+			merged[key] = sc
+			delete(missing, sc.Index)
+			contributed = true
+		}
+		if contributed {
+			sources = append(sources, src.Name())
+		}
+	}
+
+	if len(merged) == 0 && lastErr != nil {
+		return nil, nil, lastErr
+	}
+
+	out := make([]blocks.VerifiedROBlob, 0, len(merged))
+	for _, sc := range merged {
+		out = append(out, sc)
+	}
+	return out, sources, nil
+}
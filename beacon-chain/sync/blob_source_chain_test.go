@@ -0,0 +1,129 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// fakeBlobSource returns a canned set of sidecars for every Fetch call. When
+// ignoreMissing is false (the common case) it only returns sidecars the
+// caller still lists as missing, mirroring how a real source behaves.
+type fakeBlobSource struct {
+	name          string
+	sidecars      []blocks.VerifiedROBlob
+	err           error
+	ignoreMissing bool
+	calls         int
+}
+
+func (f *fakeBlobSource) Name() string { return f.name }
+
+func (f *fakeBlobSource) Fetch(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock, _ [32]byte, missing map[uint64]bool) ([]blocks.VerifiedROBlob, error) {
+	f.calls++
+	if f.ignoreMissing {
+		return f.sidecars, f.err
+	}
+	var found []blocks.VerifiedROBlob
+	for _, sc := range f.sidecars {
+		if missing[sc.Index] {
+			found = append(found, sc)
+		}
+	}
+	return found, f.err
+}
+
+func blockWithCommitments(t *testing.T, n int) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBeaconBlockDeneb()
+	commitments := make([][]byte, n)
+	for i := range commitments {
+		commitments[i] = make([]byte, 48)
+	}
+	b.Block.Body.BlobKzgCommitments = commitments
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return signed
+}
+
+func sidecarFor(root [32]byte, index uint64) blocks.VerifiedROBlob {
+	var sc blocks.VerifiedROBlob
+	sc.BlockRoot = root
+	sc.Index = index
+	return sc
+}
+
+func TestBlobSourceChain_Reconstruct_ShortCircuitsOnceFilled(t *testing.T) {
+	root := [32]byte{1}
+	signed := blockWithCommitments(t, 2)
+
+	first := &fakeBlobSource{name: "first", sidecars: []blocks.VerifiedROBlob{sidecarFor(root, 0), sidecarFor(root, 1)}}
+	second := &fakeBlobSource{name: "second", sidecars: []blocks.VerifiedROBlob{sidecarFor(root, 0)}}
+
+	chain := NewBlobSourceChain(time.Second, first, second)
+	var present [fieldparams.MaxBlobsPerBlock]bool
+	got, sources, err := chain.Reconstruct(context.Background(), signed, root, present)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	require.Equal(t, 0, second.calls)
+	require.Equal(t, 1, len(sources))
+	require.Equal(t, "first", sources[0])
+}
+
+func TestBlobSourceChain_Reconstruct_DedupesAcrossSources(t *testing.T) {
+	root := [32]byte{2}
+	signed := blockWithCommitments(t, 2)
+
+	// first only fills index 0, leaving the chain to try second.
+	first := &fakeBlobSource{name: "first", sidecars: []blocks.VerifiedROBlob{sidecarFor(root, 0)}}
+	// second ignores the missing filter (as a misbehaving source might) and
+	// returns a stale duplicate for index 0 alongside the still-missing
+	// index 1.
+	second := &fakeBlobSource{name: "second", ignoreMissing: true, sidecars: []blocks.VerifiedROBlob{sidecarFor(root, 0), sidecarFor(root, 1)}}
+
+	chain := NewBlobSourceChain(time.Second, first, second)
+	var present [fieldparams.MaxBlobsPerBlock]bool
+	got, sources, err := chain.Reconstruct(context.Background(), signed, root, present)
+	require.NoError(t, err)
+
+	seen := make(map[uint64]bool, len(got))
+	for _, sc := range got {
+		require.Equal(t, false, seen[sc.Index])
+		seen[sc.Index] = true
+	}
+	require.Equal(t, 2, len(got))
+	require.DeepEqual(t, []string{"first", "second"}, sources)
+}
+
+func TestBlobSourceChain_Reconstruct_ReturnsLastErrWhenNothingFound(t *testing.T) {
+	root := [32]byte{3}
+	signed := blockWithCommitments(t, 1)
+
+	failing := &fakeBlobSource{name: "failing", err: context.DeadlineExceeded}
+
+	chain := NewBlobSourceChain(time.Second, failing)
+	var present [fieldparams.MaxBlobsPerBlock]bool
+	got, sources, err := chain.Reconstruct(context.Background(), signed, root, present)
+	require.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+	require.Equal(t, 0, len(got))
+	require.Equal(t, 0, len(sources))
+}
+
+func TestBlobSourceChain_Reconstruct_NoCommitmentsIsNoop(t *testing.T) {
+	root := [32]byte{4}
+	signed := blockWithCommitments(t, 0)
+
+	src := &fakeBlobSource{name: "unused"}
+	chain := NewBlobSourceChain(time.Second, src)
+	var present [fieldparams.MaxBlobsPerBlock]bool
+	got, sources, err := chain.Reconstruct(context.Background(), signed, root, present)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(got))
+	require.Equal(t, 0, len(sources))
+	require.Equal(t, 0, src.calls)
+}
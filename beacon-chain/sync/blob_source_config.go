@@ -0,0 +1,97 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+)
+
+// BlobSourceName identifies one of the reconstruction sources a BlobSourceChain
+// can be built from. These are the values accepted by BlobSourceConfig.Priority.
+type BlobSourceName string
+
+const (
+	BlobSourceExecutionLayer BlobSourceName = "execution_layer"
+	BlobSourcePeerReqResp    BlobSourceName = "peer_req_resp"
+	BlobSourceBuilderRelay   BlobSourceName = "builder_relay"
+	BlobSourceDiskCache      BlobSourceName = "disk_cache"
+)
+
+// defaultBlobSourceTimeout bounds how long the chain waits on any single
+// source before moving on to the next one.
+const defaultBlobSourceTimeout = 2 * time.Second
+
+// BlobSourceConfig is the sync service's config surface (see WithBlobSources)
+// for the blob sidecar reconstruction pipeline. Disabled sources are simply omitted from
+// the chain; Priority controls the order the enabled ones are tried in. A
+// nil or empty Priority falls back to the default order above.
+type BlobSourceConfig struct {
+	// Priority is the ordered list of sources to try. Entries absent from
+	// Disabled and present here are included in that order; anything not
+	// listed is appended afterward in the default order.
+	Priority []BlobSourceName
+	// Disabled turns individual sources off regardless of Priority.
+	Disabled map[BlobSourceName]bool
+	// Timeout bounds each source's attempt. Defaults to defaultBlobSourceTimeout.
+	Timeout time.Duration
+	// Builder, when set, enables the optional builder/relay HTTP source.
+	Builder BuilderBlobFetcher
+	// DiskCache, when set, enables the optional local disk cache source.
+	DiskCache DiskBlobCache
+}
+
+func defaultBlobSourcePriority() []BlobSourceName {
+	return []BlobSourceName{
+		BlobSourceExecutionLayer,
+		BlobSourcePeerReqResp,
+		BlobSourceBuilderRelay,
+		BlobSourceDiskCache,
+	}
+}
+
+// newBlobSourceChain resolves BlobSourceConfig into a concrete chain, wiring
+// up each enabled BlobSource in priority order. Sources whose dependency
+// wasn't configured (e.g. no builder relay set) are skipped rather than
+// erroring, since all but the EL source are optional by design. Called once
+// from NewService, via resolveBlobSourceChain, when cfg.blobSourceChain isn't
+// already set directly.
+func newBlobSourceChain(cfg BlobSourceConfig, reconstructor ExecutionReconstructor, p p2p.P2P) *BlobSourceChain {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultBlobSourceTimeout
+	}
+
+	priority := append([]BlobSourceName(nil), cfg.Priority...)
+	listed := make(map[BlobSourceName]bool, len(priority))
+	for _, name := range priority {
+		listed[name] = true
+	}
+	for _, name := range defaultBlobSourcePriority() {
+		if !listed[name] {
+			priority = append(priority, name)
+		}
+	}
+
+	var sources []BlobSource
+	for _, name := range priority {
+		if cfg.Disabled[name] {
+			continue
+		}
+		switch name {
+		case BlobSourceExecutionLayer:
+			sources = append(sources, newExecutionLayerSource(reconstructor))
+		case BlobSourcePeerReqResp:
+			sources = append(sources, newPeerSource(p))
+		case BlobSourceBuilderRelay:
+			if src := newBuilderSource(cfg.Builder); src != nil {
+				sources = append(sources, src)
+			}
+		case BlobSourceDiskCache:
+			if src := newDiskCacheSource(cfg.DiskCache); src != nil {
+				sources = append(sources, src)
+			}
+		}
+	}
+
+	return NewBlobSourceChain(timeout, sources...)
+}
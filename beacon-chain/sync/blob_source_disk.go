@@ -0,0 +1,39 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+)
+
+// DiskBlobCache is a local, best-effort cache of sidecars this node has seen
+// before (e.g. ones it produced itself as a proposer, or recovered earlier
+// from the EL and later pruned from the primary blob store on rotation). It
+// is tried last because anything it holds would already have been returned
+// by blobStorage.Indices had it still been in the canonical store.
+type DiskBlobCache interface {
+	Get(blockRoot [32]byte, indices map[uint64]bool) ([]blocks.VerifiedROBlob, error)
+}
+
+// diskCacheSource is the lowest-priority, last-resort source in the default
+// chain.
+type diskCacheSource struct {
+	cache DiskBlobCache
+}
+
+// newDiskCacheSource wraps a DiskBlobCache as a BlobSource.
+func newDiskCacheSource(cache DiskBlobCache) *diskCacheSource {
+	if cache == nil {
+		return nil
+	}
+	return &diskCacheSource{cache: cache}
+}
+
+func (s *diskCacheSource) Name() string {
+	return "disk_cache"
+}
+
+func (s *diskCacheSource) Fetch(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, missing map[uint64]bool) ([]blocks.VerifiedROBlob, error) {
+	return s.cache.Get(blockRoot, missing)
+}
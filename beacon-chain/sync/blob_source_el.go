@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+)
+
+// ExecutionReconstructor is satisfied by the EL client wrapper set through
+// WithExecutionReconstructor. It is the cheapest source - no network round trip to peers
+// - so it is the default highest-priority entry in the chain.
+type ExecutionReconstructor interface {
+	ReconstructBlobSidecars(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, indices [fieldparams.MaxBlobsPerBlock]bool) ([]blocks.VerifiedROBlob, error)
+}
+
+// executionLayerSource asks the local EL, via engine_getBlobsV1 or mempool
+// lookup depending on the reconstructor implementation, to rebuild sidecars
+// for the indices it still has in its mempool.
+type executionLayerSource struct {
+	reconstructor ExecutionReconstructor
+}
+
+// newExecutionLayerSource wraps an ExecutionReconstructor as a BlobSource.
+func newExecutionLayerSource(r ExecutionReconstructor) *executionLayerSource {
+	return &executionLayerSource{reconstructor: r}
+}
+
+func (s *executionLayerSource) Name() string {
+	return "execution_layer"
+}
+
+func (s *executionLayerSource) Fetch(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, missing map[uint64]bool) ([]blocks.VerifiedROBlob, error) {
+	var present [fieldparams.MaxBlobsPerBlock]bool
+	for i := range present {
+		present[i] = !missing[uint64(i)]
+	}
+	return s.reconstructor.ReconstructBlobSidecars(ctx, block, blockRoot, present)
+}
@@ -0,0 +1,72 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// peerSource fills missing sidecars by asking peers-of-peers for them over
+// the blob_sidecars_by_root req/resp protocol. It is tried after the EL
+// since it costs a network round trip and is only useful once the block's
+// proposer (or an honest peer who already has it) is reachable.
+type peerSource struct {
+	p2p p2p.P2P
+}
+
+// newPeerSource wraps the sync service's p2p.P2P as a BlobSource.
+func newPeerSource(p p2p.P2P) *peerSource {
+	return &peerSource{p2p: p}
+}
+
+func (s *peerSource) Name() string {
+	return "peer_req_resp"
+}
+
+func (s *peerSource) Fetch(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, missing map[uint64]bool) ([]blocks.VerifiedROBlob, error) {
+	var sidecars []blocks.VerifiedROBlob
+	var lastErr error
+	for _, pid := range s.p2p.Peers().Connected() {
+		if len(missing) == 0 {
+			break
+		}
+
+		// Rebuild the request from whatever is still missing on every
+		// iteration; earlier peers in this same call may have already
+		// filled some indices, and asking a later peer for those again
+		// would waste a full round trip.
+		req := make(eth.BlobSidecarsByRootReq, 0, len(missing))
+		for idx := range missing {
+			req = append(req, &eth.BlobIdentifier{BlockRoot: blockRoot[:], Index: idx})
+		}
+
+		found, err := sendBlobSidecarsByRootRequest(ctx, s.p2p, pid, &req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		sidecars = append(sidecars, found...)
+		for _, sc := range found {
+			delete(missing, sc.Index)
+		}
+		if len(missing) == 0 {
+			break
+		}
+	}
+
+	if len(sidecars) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return sidecars, nil
+}
+
+// sendBlobSidecarsByRootRequest is a thin wrapper around the existing
+// req/resp round trip used by initial-sync, kept here so peerSource can be
+// unit tested with a fake in place of the real stream handler.
+var sendBlobSidecarsByRootRequest = func(ctx context.Context, p p2p.P2P, pid peer.ID, req *eth.BlobSidecarsByRootReq) ([]blocks.VerifiedROBlob, error) {
+	return p2p.SendBlobSidecarsByRootRequest(ctx, p, pid, req)
+}
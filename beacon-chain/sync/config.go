@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+)
+
+// blockchainService is the subset of blockchain.Service the sync package
+// depends on for receiving gossiped blocks and blobs.
+type blockchainService interface {
+	blockchain.BlockReceiver
+	blockchain.BlobReceiver
+	blockchain.TimeFetcher
+}
+
+// config bundles the sync service's external dependencies so tests can
+// inject fakes without touching Service's exported surface.
+type config struct {
+	p2p         p2p.P2P
+	chain       blockchainService
+	clock       *startup.Clock
+	blobStorage *filesystem.BlobStorage
+
+	// invalidBlocks persists gossip blocks that fail verification; nil
+	// disables persistence (see features.Get().SaveInvalidBlock).
+	invalidBlocks *invalidblocks.Store
+	// invalidBlocksConfig feeds resolveInvalidBlocks; ignored once
+	// invalidBlocks has been set directly (tests do this to skip disk I/O).
+	invalidBlocksConfig invalidblocks.Config
+
+	// executionReconstructor and blobSources feed newBlobSourceChain; set
+	// blobSourceChain directly (as tests do) to bypass that resolution.
+	executionReconstructor ExecutionReconstructor
+	blobSources            BlobSourceConfig
+	blobSourceChain        *BlobSourceChain
+}
+
+// resolveBlobSourceChain builds cfg.blobSourceChain from cfg.blobSources and
+// cfg.executionReconstructor if one hasn't already been set directly. Called
+// once from NewService.
+func (cfg *config) resolveBlobSourceChain() {
+	if cfg.blobSourceChain != nil {
+		return
+	}
+	cfg.blobSourceChain = newBlobSourceChain(cfg.blobSources, cfg.executionReconstructor, cfg.p2p)
+}
+
+// resolveInvalidBlocks opens cfg.invalidBlocks from cfg.invalidBlocksConfig if
+// one hasn't already been set directly. Called once from NewService; a nop
+// when invalidBlocksConfig.Dir is empty, which leaves persistence disabled.
+func (cfg *config) resolveInvalidBlocks() error {
+	if cfg.invalidBlocks != nil || cfg.invalidBlocksConfig.Dir == "" {
+		return nil
+	}
+	store, err := invalidblocks.New(cfg.invalidBlocksConfig)
+	if err != nil {
+		return err
+	}
+	cfg.invalidBlocks = store
+	return nil
+}
+
+// defaultBlobSourceConfig returns the BlobSourceConfig built from CLI flags
+// (see cmd/beacon-chain/flags.BlobSourcePriorityFlag and friends), used to
+// populate config.blobSources at node construction time.
+func defaultBlobSourceConfig(priority []BlobSourceName, disabled []BlobSourceName, timeout time.Duration, builder BuilderBlobFetcher, diskCache DiskBlobCache) BlobSourceConfig {
+	disabledSet := make(map[BlobSourceName]bool, len(disabled))
+	for _, name := range disabled {
+		disabledSet[name] = true
+	}
+	return BlobSourceConfig{
+		Priority:  priority,
+		Disabled:  disabledSet,
+		Timeout:   timeout,
+		Builder:   builder,
+		DiskCache: diskCache,
+	}
+}
@@ -0,0 +1,36 @@
+package sync
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// BlobSourceConfigFromContext builds a BlobSourceConfig from the
+// blob-source-* CLI flags (see cmd/beacon-chain/flags.BlobSourcePriorityFlag
+// and friends). builder and diskCache are passed through as-is since neither
+// one has a CLI-configurable form - set them to nil to leave those optional
+// sources disabled.
+func BlobSourceConfigFromContext(cliCtx *cli.Context, builder BuilderBlobFetcher, diskCache DiskBlobCache) BlobSourceConfig {
+	var priority, disabled []BlobSourceName
+	for _, v := range cliCtx.StringSlice(flags.BlobSourcePriorityFlag.Name) {
+		priority = append(priority, BlobSourceName(v))
+	}
+	for _, v := range cliCtx.StringSlice(flags.BlobSourceDisableFlag.Name) {
+		disabled = append(disabled, BlobSourceName(v))
+	}
+	return defaultBlobSourceConfig(priority, disabled, cliCtx.Duration(flags.BlobSourceTimeoutFlag.Name), builder, diskCache)
+}
+
+// InvalidBlockConfigFromContext builds an invalidblocks.Config from the
+// invalid-block-store-* CLI flags (see
+// cmd/beacon-chain/flags.InvalidBlockStorePathFlag and friends). An unset
+// InvalidBlockStorePathFlag yields a zero-value Config, which
+// resolveInvalidBlocks treats as "persistence disabled".
+func InvalidBlockConfigFromContext(cliCtx *cli.Context) invalidblocks.Config {
+	return invalidblocks.Config{
+		Dir:        cliCtx.String(flags.InvalidBlockStorePathFlag.Name),
+		MaxBackups: cliCtx.Int(flags.InvalidBlockStoreMaxBackupsFlag.Name),
+		Compress:   cliCtx.Bool(flags.InvalidBlockStoreCompressFlag.Name),
+	}
+}
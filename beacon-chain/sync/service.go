@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/invalidblocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+)
+
+// Service is the sync package's entry point. Its exported surface is
+// intentionally thin - almost everything it depends on lives on the
+// unexported cfg so it can be swapped out from NewService without ever
+// becoming part of the package's public API.
+type Service struct {
+	cfg *config
+}
+
+// Option configures a Service at construction time. Each With* function below
+// assigns one dependency onto cfg; WithBlobSourceChain and
+// WithInvalidBlockStore set already-built values directly, which NewService
+// then leaves as they are instead of resolving them.
+type Option func(*Service)
+
+// NewService builds a Service from the given options and resolves whatever
+// config those options didn't set directly: the blob source chain (from
+// WithBlobSources/WithExecutionReconstructor) and, if configured, the invalid
+// block store (from WithInvalidBlockStoreConfig). This is the one place both
+// resolutions are expected to run; call sites that build a *Service any
+// other way are responsible for resolving cfg themselves first.
+//
+// Pass WithInvalidBlockStore instead of WithInvalidBlockStoreConfig (and read
+// the result back via InvalidBlockStore) to share one store instance with
+// debug.Server rather than letting each side open its own.
+func NewService(opts ...Option) (*Service, error) {
+	s := &Service{cfg: &config{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.cfg.resolveBlobSourceChain()
+	if err := s.cfg.resolveInvalidBlocks(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// InvalidBlockStore returns the store resolved (or injected) during
+// construction, or nil if invalid block persistence isn't configured. Hand
+// the same pointer to debug.Server{InvalidBlockStore: ...} so the gossip path
+// and the debug export API read and write the same backing files.
+func (s *Service) InvalidBlockStore() *invalidblocks.Store {
+	return s.cfg.invalidBlocks
+}
+
+// WithP2P sets the p2p service used to broadcast and request gossip.
+func WithP2P(p p2p.P2P) Option {
+	return func(s *Service) { s.cfg.p2p = p }
+}
+
+// WithChainService sets the blockchain service used to receive blocks/blobs
+// and read genesis time.
+func WithChainService(c blockchainService) Option {
+	return func(s *Service) { s.cfg.chain = c }
+}
+
+// WithClock sets the wall clock used to compute how long reconstruction took
+// relative to slot start.
+func WithClock(c *startup.Clock) Option {
+	return func(s *Service) { s.cfg.clock = c }
+}
+
+// WithBlobStorage sets the on-disk blob store checked before reconstruction
+// and written to after it.
+func WithBlobStorage(b *filesystem.BlobStorage) Option {
+	return func(s *Service) { s.cfg.blobStorage = b }
+}
+
+// WithExecutionReconstructor sets the EL client wrapper the execution-layer
+// blob source reconstructs sidecars through.
+func WithExecutionReconstructor(r ExecutionReconstructor) Option {
+	return func(s *Service) { s.cfg.executionReconstructor = r }
+}
+
+// WithBlobSources sets the priority/disable/timeout config resolveBlobSourceChain
+// builds the chain from. See BlobSourceConfigFromContext to build this from CLI flags.
+func WithBlobSources(cfg BlobSourceConfig) Option {
+	return func(s *Service) { s.cfg.blobSources = cfg }
+}
+
+// WithBlobSourceChain injects an already-built chain, bypassing resolution
+// entirely. Tests use this to install fakes; production callers should
+// prefer WithBlobSources and let NewService resolve it.
+func WithBlobSourceChain(chain *BlobSourceChain) Option {
+	return func(s *Service) { s.cfg.blobSourceChain = chain }
+}
+
+// WithInvalidBlockStoreConfig sets the config resolveInvalidBlocks opens the
+// store from. See InvalidBlockConfigFromContext to build this from CLI flags.
+func WithInvalidBlockStoreConfig(cfg invalidblocks.Config) Option {
+	return func(s *Service) { s.cfg.invalidBlocksConfig = cfg }
+}
+
+// WithInvalidBlockStore injects an already-opened store, bypassing resolution
+// entirely. Use this to share a single store instance with debug.Server
+// instead of letting sync open a second one from WithInvalidBlockStoreConfig.
+func WithInvalidBlockStore(store *invalidblocks.Store) Option {
+	return func(s *Service) { s.cfg.invalidBlocks = store }
+}
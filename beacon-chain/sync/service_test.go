@@ -0,0 +1,21 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNewService_ResolvesBlobSourceChain(t *testing.T) {
+	s, err := NewService(WithBlobSources(BlobSourceConfig{Timeout: time.Second}))
+	require.NoError(t, err)
+	require.Equal(t, true, s.cfg.blobSourceChain != nil)
+}
+
+func TestNewService_BlobSourceChainInjectedBypassesResolution(t *testing.T) {
+	chain := NewBlobSourceChain(time.Second)
+	s, err := NewService(WithBlobSourceChain(chain))
+	require.NoError(t, err)
+	require.Equal(t, chain, s.cfg.blobSourceChain)
+}
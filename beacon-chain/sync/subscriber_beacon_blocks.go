@@ -3,16 +3,14 @@ package sync
 import (
 	"context"
 	"fmt"
-	"os"
-	"path"
+	"strings"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition/interop"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
-	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -34,18 +32,37 @@ func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 		return err
 	}
 
-	go s.attemptBlobSaveAndBroadcast(ctx, signed)
-
-	if err := s.cfg.chain.ReceiveBlock(ctx, signed, root, nil); err != nil {
+	ctx, span := trace.StartSpan(ctx, "sync.beaconBlockSubscriber")
+	defer span.End()
+	span.AddAttributes(
+		trace.Int64Attribute("slot", int64(block.Slot())),
+		trace.Int64Attribute("proposerIndex", int64(block.ProposerIndex())),
+		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", root)),
+	)
+
+	// The goroutine outlives this handler's return, so it gets a context that
+	// is detached from cancellation but still carries the parent span.
+	go s.attemptBlobSaveAndBroadcast(context.WithoutCancel(ctx), signed)
+
+	receiveCtx, receiveSpan := trace.StartSpan(ctx, "sync.beaconBlockSubscriber.ReceiveBlock")
+	err = s.cfg.chain.ReceiveBlock(receiveCtx, signed, root, nil)
+	receiveSpan.End()
+	if err != nil {
 		if blockchain.IsInvalidBlock(err) {
 			r := blockchain.InvalidBlockRoot(err)
 			if r != [32]byte{} {
 				s.setBadBlock(ctx, r) // Setting head block as bad.
 			} else {
-				// TODO(13721): Remove this once we can deprecate the flag.
-				interop.WriteBlockToDisk(signed, true /*failed*/)
-
-				saveInvalidBlockToTemp(signed)
+				// Persisting to disk is opt-in: it's a synchronous write on
+				// the gossip validation hot path, so operators who don't
+				// want the cost (or the disk usage) can turn it off.
+				if features.Get().SaveInvalidBlock && s.cfg.invalidBlocks != nil {
+					// The gossip message's originating peer isn't threaded through
+					// this handler's signature, so we can only record it as unknown.
+					if saveErr := s.cfg.invalidBlocks.Save(ctx, signed, "failed gossip validation", err, blockchain.InvalidAncestorRoots(err), ""); saveErr != nil {
+						log.WithError(saveErr).Error("Failed to save invalid block")
+					}
+				}
 				s.setBadBlock(ctx, root)
 			}
 		}
@@ -59,8 +76,11 @@ func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 }
 
 // attemptBlobSaveAndBroadcast handles the process of saving and broadcasting blobs
-// by reconstructing blob sidecars from the execution layer (EL) and integrating them into the chain.
+// by reconstructing blob sidecars via the configured source chain and integrating them into the chain.
 func (s *Service) attemptBlobSaveAndBroadcast(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) {
+	ctx, span := trace.StartSpan(ctx, "sync.attemptBlobSaveAndBroadcast")
+	defer span.End()
+
 	startTime, err := slots.ToTime(uint64(s.cfg.chain.GenesisTime().Unix()), block.Block().Slot())
 	if err != nil {
 		log.WithError(err).Error("Failed to convert slot to time")
@@ -78,8 +98,30 @@ func (s *Service) attemptBlobSaveAndBroadcast(ctx context.Context, block interfa
 		return
 	}
 
-	// Reconstruct blob sidecars from the EL
-	blobSidecars, err := s.cfg.executionReconstructor.ReconstructBlobSidecars(ctx, block, blockRoot, indices)
+	missing := 0
+	for _, present := range indices {
+		if !present {
+			missing++
+		}
+	}
+
+	if s.cfg.blobSourceChain == nil {
+		// No source chain was configured: NewService always resolves one, so
+		// this only happens for a Service built some other way. There's
+		// nothing to reconstruct from.
+		return
+	}
+
+	reconstructCtx, reconstructSpan := trace.StartSpan(ctx, "sync.attemptBlobSaveAndBroadcast.ReconstructBlobSidecars")
+	reconstructSpan.AddAttributes(trace.Int64Attribute("missingIndices", int64(missing)))
+	// Reconstruct blob sidecars by walking the configured source chain (EL
+	// mempool, peer req/resp, builder/relay, disk cache) in priority order.
+	blobSidecars, sources, err := s.cfg.blobSourceChain.Reconstruct(reconstructCtx, block, blockRoot, indices)
+	reconstructSpan.AddAttributes(
+		trace.StringAttribute("sources", strings.Join(sources, ",")),
+		trace.Int64Attribute("sinceSlotStartTimeMs", s.cfg.clock.Now().Sub(startTime).Milliseconds()),
+	)
+	reconstructSpan.End()
 	if err != nil {
 		log.WithError(err).Error("Failed to reconstruct blob sidecars")
 		return
@@ -101,7 +143,11 @@ func (s *Service) attemptBlobSaveAndBroadcast(ctx context.Context, block interfa
 			continue // Skip if the blob already exists in the database
 		}
 
-		if err := s.cfg.p2p.BroadcastBlob(ctx, sidecar.Index, sidecar.BlobSidecar); err != nil {
+		_, broadcastSpan := trace.StartSpan(ctx, "sync.attemptBlobSaveAndBroadcast.BroadcastBlob")
+		broadcastSpan.AddAttributes(trace.Int64Attribute("index", int64(sidecar.Index)))
+		err := s.cfg.p2p.BroadcastBlob(ctx, sidecar.Index, sidecar.BlobSidecar)
+		broadcastSpan.End()
+		if err != nil {
 			log.WithFields(blobFields(sidecar.ROBlob)).WithError(err).Error("Failed to broadcast blob sidecar")
 		}
 	}
@@ -112,32 +158,20 @@ func (s *Service) attemptBlobSaveAndBroadcast(ctx context.Context, block interfa
 			continue
 		}
 
-		if err := s.cfg.chain.ReceiveBlob(ctx, sidecar); err != nil {
+		receiveCtx, receiveSpan := trace.StartSpan(ctx, "sync.attemptBlobSaveAndBroadcast.ReceiveBlob")
+		receiveSpan.AddAttributes(trace.Int64Attribute("index", int64(sidecar.Index)))
+		err := s.cfg.chain.ReceiveBlob(receiveCtx, sidecar)
+		receiveSpan.End()
+		if err != nil {
 			log.WithFields(blobFields(sidecar.ROBlob)).WithError(err).Error("Failed to receive blob")
 		}
 
-		blobRecoveredFromELCount.Inc()
+		sourceLabel := strings.Join(sources, ",")
+		blobRecoveredCount.WithLabelValues(sourceLabel).Inc()
 
 		fields := blobFields(sidecar.ROBlob)
 		fields["sinceSlotStartTime"] = s.cfg.clock.Now().Sub(startTime)
-		log.WithFields(fields).Debug("Processed blob sidecar from EL")
-	}
-}
-
-// WriteInvalidBlockToDisk as a block ssz. Writes to temp directory.
-func saveInvalidBlockToTemp(block interfaces.ReadOnlySignedBeaconBlock) {
-	if !features.Get().SaveInvalidBlock {
-		return
-	}
-	filename := fmt.Sprintf("beacon_block_%d.ssz", block.Block().Slot())
-	fp := path.Join(os.TempDir(), filename)
-	log.Warnf("Writing invalid block to disk at %s", fp)
-	enc, err := block.MarshalSSZ()
-	if err != nil {
-		log.WithError(err).Error("Failed to ssz encode block")
-		return
-	}
-	if err := file.WriteFile(fp, enc); err != nil {
-		log.WithError(err).Error("Failed to write to disk")
+		fields["sources"] = sourceLabel
+		log.WithFields(fields).Debug("Processed blob sidecar from reconstruction")
 	}
 }
@@ -0,0 +1,32 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// BlobSourcePriorityFlag overrides the default blob sidecar reconstruction
+// source order (see sync.BlobSourceConfig.Priority). Accepts a
+// comma-separated list drawn from execution_layer, peer_req_resp,
+// builder_relay, disk_cache; sources omitted from the list keep their
+// default position after the ones named.
+var BlobSourcePriorityFlag = &cli.StringSliceFlag{
+	Name:  "blob-source-priority",
+	Usage: "Ordered list of blob sidecar reconstruction sources to try (execution_layer, peer_req_resp, builder_relay, disk_cache).",
+}
+
+// BlobSourceDisableFlag turns off individual blob reconstruction sources
+// regardless of BlobSourcePriorityFlag.
+var BlobSourceDisableFlag = &cli.StringSliceFlag{
+	Name:  "blob-source-disable",
+	Usage: "Blob sidecar reconstruction sources to disable entirely (execution_layer, peer_req_resp, builder_relay, disk_cache).",
+}
+
+// BlobSourceTimeoutFlag bounds how long the reconstruction chain waits on a
+// single source before moving on to the next one.
+var BlobSourceTimeoutFlag = &cli.DurationFlag{
+	Name:  "blob-source-timeout",
+	Usage: "Per-source timeout for blob sidecar reconstruction.",
+	Value: 2 * time.Second,
+}
@@ -0,0 +1,25 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// InvalidBlockStorePathFlag sets the directory gossip blocks that fail
+// verification are persisted to (see invalidblocks.Config.Dir). Unset
+// disables persistence outright, independent of SaveInvalidBlockFlag.
+var InvalidBlockStorePathFlag = &cli.StringFlag{
+	Name:  "invalid-block-store-path",
+	Usage: "Directory invalid gossip blocks are persisted to for post-mortem debugging via the /prysm/v1/debug/invalid-blocks API.",
+}
+
+// InvalidBlockStoreMaxBackupsFlag bounds how many invalid block entries are
+// retained before the oldest are evicted.
+var InvalidBlockStoreMaxBackupsFlag = &cli.IntFlag{
+	Name:  "invalid-block-store-max-backups",
+	Usage: "Maximum number of invalid block entries retained before the oldest are evicted. Zero means unlimited.",
+	Value: 50,
+}
+
+// InvalidBlockStoreCompressFlag gzip-encodes persisted invalid block payloads.
+var InvalidBlockStoreCompressFlag = &cli.BoolFlag{
+	Name:  "invalid-block-store-compress",
+	Usage: "Gzip-compress invalid block payloads written to invalid-block-store-path.",
+}